@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/spf13/cobra"
+
+	"github.com/jhkimqd/docker-logger/internal/docker"
+)
+
+func newEventsCommand(root *rootOptions) *cobra.Command {
+	var networkName string
+
+	cmd := &cobra.Command{
+		Use:   "events",
+		Short: "Stream and colorize Docker events for a network's containers",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runEvents(cmd, root, networkName)
+		},
+	}
+
+	cmd.Flags().StringVar(&networkName, "network", "", "Docker network name to watch")
+	cmd.MarkFlagRequired("network")
+
+	return cmd
+}
+
+func runEvents(cmd *cobra.Command, root *rootOptions, networkName string) error {
+	ctx := cmd.Context()
+
+	clientOptsList := root.clientOptionsList()
+	var wg sync.WaitGroup
+	for _, co := range clientOptsList {
+		wg.Add(1)
+		go func(co docker.ClientOptions) {
+			defer wg.Done()
+			if err := streamNetworkEvents(ctx, co, networkName); err != nil {
+				fmt.Printf("Error: %v\n", err)
+			}
+		}(co)
+	}
+	wg.Wait()
+	return nil
+}
+
+func streamNetworkEvents(ctx context.Context, clientOpts docker.ClientOptions, networkName string) error {
+	cli, err := docker.CreateClient(clientOpts)
+	if err != nil {
+		return fmt.Errorf("failed to initialize Docker client for host %q: %v", clientOpts.Host, err)
+	}
+	defer cli.Close()
+
+	eventFilters := filters.NewArgs(
+		filters.Arg("type", "network"),
+		filters.Arg("type", "container"),
+		filters.Arg("network", networkName),
+	)
+
+	messages, errs := cli.Events(ctx, types.EventsOptions{Filters: eventFilters})
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errs:
+			return err
+		case msg := <-messages:
+			printEvent(msg)
+		}
+	}
+}
+
+// printEvent colorizes a Docker event: green for containers/networks coming
+// up (start, connect), yellow for them going away (die, disconnect).
+func printEvent(msg events.Message) {
+	eventColor := normalColor
+	switch msg.Action {
+	case "die", "disconnect", "stop", "kill":
+		eventColor = warningColor
+	}
+
+	actor := msg.Actor.ID
+	if len(actor) > 12 {
+		actor = actor[:12]
+	}
+
+	eventColor.Printf("[%s] [%s] %s %s\n", time.Unix(msg.Time, 0).UTC().Format("2006-01-02 15:04:05"), msg.Type, msg.Action, actor)
+}
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+)
+
+// watchNetworkEvents subscribes to Docker network/container events scoped to
+// networkName and invokes onJoin/onLeave as containers join or leave it, so
+// monitorLogs can follow containers started after the initial enumeration
+// instead of only tailing the snapshot taken at startup.
+//
+// It reconnects the events stream with exponential backoff on transient
+// errors and returns only once ctx is cancelled.
+func watchNetworkEvents(ctx context.Context, cli *client.Client, networkName string, onJoin func(id, name string), onLeave func(id string)) {
+	backoff := time.Second
+	const maxBackoff = 30 * time.Second
+
+	for ctx.Err() == nil {
+		err := streamJoinLeaveEvents(ctx, cli, networkName, onJoin, onLeave)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			fmt.Printf("Events stream error, reconnecting in %s: %v\n", backoff, err)
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return
+			}
+			if backoff *= 2; backoff > maxBackoff {
+				backoff = maxBackoff
+			}
+			continue
+		}
+		backoff = time.Second
+	}
+}
+
+// streamJoinLeaveEvents runs a single Docker events subscription until it
+// ends (cleanly or with an error) or ctx is cancelled.
+func streamJoinLeaveEvents(ctx context.Context, cli *client.Client, networkName string, onJoin func(id, name string), onLeave func(id string)) error {
+	eventFilters := filters.NewArgs(
+		filters.Arg("type", "network"),
+		filters.Arg("type", "container"),
+		filters.Arg("event", "connect"),
+		filters.Arg("event", "disconnect"),
+		filters.Arg("event", "start"),
+		filters.Arg("event", "die"),
+		filters.Arg("network", networkName),
+	)
+
+	messages, errs := cli.Events(ctx, types.EventsOptions{Filters: eventFilters})
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errs:
+			if err == nil || err == io.EOF {
+				return nil
+			}
+			return err
+		case msg := <-messages:
+			handleJoinLeaveEvent(ctx, cli, networkName, msg, onJoin, onLeave)
+		}
+	}
+}
+
+func handleJoinLeaveEvent(ctx context.Context, cli *client.Client, networkName string, msg events.Message, onJoin func(id, name string), onLeave func(id string)) {
+	switch msg.Type {
+	case "network":
+		containerID, ok := msg.Actor.Attributes["container"]
+		if !ok {
+			return
+		}
+		switch msg.Action {
+		case "connect":
+			info, err := cli.ContainerInspect(ctx, containerID)
+			if err != nil {
+				return
+			}
+			onJoin(containerID, info.Name)
+		case "disconnect":
+			onLeave(containerID)
+		}
+	case "container":
+		containerID := msg.Actor.ID
+		switch msg.Action {
+		case "start":
+			info, err := cli.ContainerInspect(ctx, containerID)
+			if err != nil {
+				return
+			}
+			if _, inNetwork := info.NetworkSettings.Networks[networkName]; inNetwork {
+				onJoin(containerID, info.Name)
+			}
+		case "die":
+			onLeave(containerID)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jhkimqd/docker-logger/internal/docker"
+)
+
+func newInspectCommand(root *rootOptions) *cobra.Command {
+	var (
+		networkName    string
+		labels         []string
+		composeProject string
+		composeService string
+		serviceNames   []string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "inspect",
+		Short: "Show the containers that would be tailed given the current network/label/service selectors",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runInspect(cmd, root, networkName, docker.DiscoverOptions{
+				Labels:         labels,
+				ComposeProject: composeProject,
+				ComposeService: composeService,
+			}, serviceNames)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&networkName, "network", "", "Docker network name to inspect")
+	cmd.MarkFlagRequired("network")
+	flags.StringArrayVar(&labels, "label", nil, "Filter containers by label key=value (repeatable)")
+	flags.StringVar(&composeProject, "compose-project", "", "Filter containers by Docker Compose project")
+	flags.StringVar(&composeService, "compose-service", "", "Filter containers by Docker Compose service")
+	flags.StringArrayVar(&serviceNames, "service", nil, "Filter by service/container name (partial match, repeatable)")
+
+	return cmd
+}
+
+func runInspect(cmd *cobra.Command, root *rootOptions, networkName string, discoverOpts docker.DiscoverOptions, serviceNames []string) error {
+	ctx := cmd.Context()
+
+	for _, co := range root.clientOptionsList() {
+		cli, err := docker.CreateClient(co)
+		if err != nil {
+			return fmt.Errorf("failed to initialize Docker client for host %q: %v", co.Host, err)
+		}
+
+		containers, err := docker.DiscoverContainers(ctx, cli, networkName, discoverOpts)
+		cli.Close()
+		if err != nil {
+			return fmt.Errorf("failed to discover containers on host %q: %v", co.Host, err)
+		}
+
+		if co.Host != "" {
+			fmt.Printf("Host: %s\n", co.Host)
+		}
+		fmt.Println("CONTAINER ID\tNAME\tSERVICE")
+		for _, c := range containers {
+			name := docker.ContainerDisplayName(c)
+			if !matchesServiceName(name, serviceNames) {
+				continue
+			}
+
+			service := c.Labels["com.docker.compose.service"]
+			if service == "" {
+				service = name
+			}
+
+			id := c.ID
+			if len(id) > 12 {
+				id = id[:12]
+			}
+			fmt.Printf("%s\t%s\t%s\n", id, name, service)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,699 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/jhkimqd/docker-logger/internal/docker"
+	"github.com/jhkimqd/docker-logger/internal/format"
+)
+
+// Colors for log output
+var (
+	normalColor  = color.New(color.FgGreen)
+	warningColor = color.New(color.FgYellow, color.Bold)
+	errorColor   = color.New(color.FgRed, color.Bold)
+)
+
+// logOptions holds the logs subcommand's own flags.
+type logOptions struct {
+	networkName    string
+	showAll        bool
+	showErrors     bool
+	showWarns      bool
+	showInfo       bool
+	showDebug      bool
+	customWords    string
+	logLevels      string
+	serviceNames   []string
+	labels         []string
+	composeProject string
+	composeService string
+
+	// since/until/tail/noFollow control how much history Docker replays and
+	// whether the stream keeps following after catching up.
+	since    string
+	until    string
+	tail     string
+	noFollow bool
+
+	// outDir, when set, mirrors each container's filtered output to a
+	// rotating file sink in addition to stdout.
+	outDir           string
+	rotateMaxSizeMB  int
+	rotateMaxAgeDays int
+	rotateMaxBackups int
+}
+
+// streamConfig is threaded down into the streaming pipeline: the logs
+// subcommand's filters, the resolved output formatter, and (when fanning out
+// across multiple --host values) a short host tag.
+type streamConfig struct {
+	logOptions
+	outputFormat string
+	formatter    format.Formatter
+	hostTag      string
+}
+
+func newLogsCommand(root *rootOptions) *cobra.Command {
+	opts := &logOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Tail logs for every container on a Docker network",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLogs(cmd, root, opts)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.networkName, "network", "", "Docker network name to monitor")
+	cmd.MarkFlagRequired("network")
+	flags.BoolVar(&opts.showAll, "all", false, "Show all logs (default: false)")
+	flags.BoolVar(&opts.showErrors, "errors", false, "Show error logs (default: false)")
+	flags.BoolVar(&opts.showWarns, "warnings", false, "Show warning logs (default: false)")
+	flags.BoolVar(&opts.showInfo, "info", false, "Show info logs (default: false)")
+	flags.BoolVar(&opts.showDebug, "debug", false, "Show debug logs (default: false)")
+	flags.StringVar(&opts.customWords, "filter", "", "Additional keywords to filter, comma-separated")
+	flags.StringVar(&opts.logLevels, "levels", "", "Comma-separated log levels to show (error,warn,info,debug)")
+	flags.StringArrayVar(&opts.serviceNames, "service", nil, "Filter logs by service names (partial match, repeatable)")
+	flags.StringArrayVar(&opts.labels, "label", nil, "Filter containers by label key=value (repeatable)")
+	flags.StringVar(&opts.composeProject, "compose-project", "", "Filter containers by Docker Compose project (com.docker.compose.project label)")
+	flags.StringVar(&opts.composeService, "compose-service", "", "Filter containers by Docker Compose service (com.docker.compose.service label)")
+	flags.StringVar(&opts.since, "since", "", "Show logs since this time (RFC3339 timestamp or relative duration like 15m, 2h)")
+	flags.StringVar(&opts.until, "until", "", "Show logs until this time (RFC3339 timestamp or relative duration like 15m, 2h); implies --no-follow")
+	flags.StringVar(&opts.tail, "tail", "", "Number of lines to show from the end of the logs (default: all)")
+	flags.BoolVar(&opts.noFollow, "no-follow", false, "Fetch existing logs and exit instead of following new output")
+	flags.StringVar(&opts.outDir, "out-dir", "", "Also write each container's filtered logs to a rotating file in this directory")
+	flags.IntVar(&opts.rotateMaxSizeMB, "rotate-max-size", 100, "Max size in megabytes of a log file before it gets rotated (requires --out-dir)")
+	flags.IntVar(&opts.rotateMaxAgeDays, "rotate-max-age", 0, "Max age in days to retain rotated log files, 0 means no limit (requires --out-dir)")
+	flags.IntVar(&opts.rotateMaxBackups, "rotate-max-backups", 0, "Max number of rotated log files to retain, 0 means no limit (requires --out-dir)")
+
+	return cmd
+}
+
+func runLogs(cmd *cobra.Command, root *rootOptions, opts *logOptions) error {
+	// If no specific level is selected, show all
+	if !opts.showErrors && !opts.showWarns && !opts.showInfo && !opts.showDebug && opts.logLevels == "" {
+		opts.showAll = true
+	}
+
+	// Parse log levels if specified
+	if opts.logLevels != "" {
+		for _, level := range strings.Split(strings.ToLower(opts.logLevels), ",") {
+			switch strings.TrimSpace(level) {
+			case "error":
+				opts.showErrors = true
+			case "warn", "warning":
+				opts.showWarns = true
+			case "info":
+				opts.showInfo = true
+			case "debug":
+				opts.showDebug = true
+			}
+		}
+	}
+
+	formatter, err := format.New(root.outputFormat)
+	if err != nil {
+		return err
+	}
+
+	since, err := resolveTimestamp(opts.since)
+	if err != nil {
+		return fmt.Errorf("invalid --since: %v", err)
+	}
+	opts.since = since
+
+	until, err := resolveTimestamp(opts.until)
+	if err != nil {
+		return fmt.Errorf("invalid --until: %v", err)
+	}
+	opts.until = until
+	if opts.until != "" {
+		// Nothing will ever arrive after the cutoff, so there's no point
+		// keeping the stream open waiting for it.
+		opts.noFollow = true
+	}
+
+	if opts.outDir != "" {
+		if err := os.MkdirAll(opts.outDir, 0o755); err != nil {
+			return fmt.Errorf("failed to create --out-dir %q: %v", opts.outDir, err)
+		}
+	}
+
+	ctx := cmd.Context()
+
+	// Handle Ctrl+C
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt)
+	go func() {
+		<-sigChan
+		fmt.Println("\nStopping log monitor...")
+		os.Exit(0)
+	}()
+
+	// Monitor logs on each host concurrently; with a single host this is just
+	// one goroutine and behaves exactly as before
+	clientOptsList := root.clientOptionsList()
+	tagOutput := len(clientOptsList) > 1
+
+	var wg sync.WaitGroup
+	for _, co := range clientOptsList {
+		wg.Add(1)
+		go func(co docker.ClientOptions) {
+			defer wg.Done()
+			sc := &streamConfig{logOptions: *opts, outputFormat: root.outputFormat, formatter: formatter}
+			if tagOutput {
+				sc.hostTag = shortHostTag(co.Host)
+			}
+			if err := monitorHost(ctx, co, opts.networkName, sc); err != nil {
+				fmt.Printf("Error: %v\n", err)
+			}
+		}(co)
+	}
+	wg.Wait()
+	return nil
+}
+
+// monitorHost connects to a single Docker daemon and monitors the given
+// network on it.
+func monitorHost(ctx context.Context, clientOpts docker.ClientOptions, networkName string, config *streamConfig) error {
+	cli, err := docker.CreateClient(clientOpts)
+	if err != nil {
+		return fmt.Errorf("failed to initialize Docker client for host %q: %v", clientOpts.Host, err)
+	}
+	defer cli.Close()
+
+	return monitorLogs(ctx, cli, networkName, config)
+}
+
+// monitorLogs tails every matching container currently on the network and
+// keeps following it afterwards: containers that join later are picked up
+// via Docker events, and containers that leave or die have their log stream
+// torn down.
+func monitorLogs(ctx context.Context, cli *client.Client, networkName string, config *streamConfig) error {
+	discoverOpts := docker.DiscoverOptions{
+		Labels:         config.labels,
+		ComposeProject: config.composeProject,
+		ComposeService: config.composeService,
+	}
+
+	// Resolve the containers to tail server-side (label/Compose project/service
+	// filters), instead of enumerating the whole network and matching names
+	// client-side
+	containers, err := docker.DiscoverContainers(ctx, cli, networkName, discoverOpts)
+	if err != nil {
+		return fmt.Errorf("failed to discover containers in network '%s': %v", networkName, err)
+	}
+
+	if len(containers) == 0 {
+		if config.noFollow {
+			fmt.Printf("No containers found in network '%s'.\n", networkName)
+		} else {
+			fmt.Printf("No containers found in network '%s' yet; waiting for containers to join...\n", networkName)
+		}
+	}
+	fmt.Printf("Monitoring logs for network '%s'...\n", networkName)
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		tailing = make(map[string]context.CancelFunc)
+	)
+
+	onJoin := func(id, name string) {
+		mu.Lock()
+		if _, exists := tailing[id]; exists {
+			mu.Unlock()
+			return
+		}
+		cctx, cancel := context.WithCancel(ctx)
+		tailing[id] = cancel
+		mu.Unlock()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			streamContainerLogs(cctx, cli, id, name, config)
+			mu.Lock()
+			delete(tailing, id)
+			mu.Unlock()
+		}()
+	}
+
+	onLeave := func(id string) {
+		mu.Lock()
+		cancel, exists := tailing[id]
+		if exists {
+			delete(tailing, id)
+		}
+		mu.Unlock()
+		if exists {
+			cancel()
+		}
+	}
+
+	for _, c := range containers {
+		onJoin(c.ID, docker.ContainerDisplayName(c))
+	}
+
+	// With --no-follow (or --until), each stream above already ends on its
+	// own once the requested history has been read; don't start watching for
+	// new joiners, or wg.Wait() below would block forever on an events
+	// subscription nothing will ever cancel.
+	if !config.noFollow {
+		// Any label/Compose selectors apply to containers that join later
+		// too; a container joining the network is only picked up if it
+		// still matches.
+		filteredOnJoin := onJoin
+		if len(discoverOpts.Labels) > 0 || discoverOpts.ComposeProject != "" || discoverOpts.ComposeService != "" {
+			filteredOnJoin = func(id, name string) {
+				info, err := cli.ContainerInspect(ctx, id)
+				if err != nil || !docker.MatchesDiscoverOptions(info.Config.Labels, discoverOpts) {
+					return
+				}
+				onJoin(id, name)
+			}
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			watchNetworkEvents(ctx, cli, networkName, filteredOnJoin, onLeave)
+		}()
+	}
+
+	// Wait for all goroutines to finish (they won't unless interrupted, or
+	// --no-follow/--until mean they complete on their own)
+	wg.Wait()
+	return nil
+}
+
+func streamContainerLogs(ctx context.Context, cli *client.Client, containerID, containerName string, config *streamConfig) {
+	// Get container details to fetch service name (if using Docker Compose)
+	containerInfo, err := cli.ContainerInspect(ctx, containerID)
+	if err != nil {
+		fmt.Printf("Error inspecting container %s: %v\n", containerName, err)
+		return
+	}
+
+	// Use service name from Docker Compose labels, fallback to container name
+	serviceName := containerName
+	if labels, exists := containerInfo.Config.Labels["com.docker.compose.service"]; exists {
+		serviceName = labels
+	}
+
+	// Check if container name matches the service filter
+	if !matchesServiceName(containerName, config.serviceNames) {
+		return
+	}
+
+	// Stream logs, requesting daemon-side timestamps so we report the log's
+	// actual time instead of the time we happened to read it
+	logs, err := cli.ContainerLogs(ctx, containerID, types.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     !config.noFollow,
+		Timestamps: true,
+		Since:      config.since,
+		Until:      config.until,
+		Tail:       config.tail,
+	})
+	if err != nil {
+		fmt.Printf("Error streaming logs for %s: %v\n", serviceName, err)
+		return
+	}
+	defer logs.Close()
+
+	var sink io.Writer
+	if config.outDir != "" {
+		fileSink := &lumberjack.Logger{
+			Filename:   filepath.Join(config.outDir, serviceName+".log"),
+			MaxSize:    config.rotateMaxSizeMB,
+			MaxAge:     config.rotateMaxAgeDays,
+			MaxBackups: config.rotateMaxBackups,
+		}
+		defer fileSink.Close()
+		sink = fileSink
+	}
+
+	// Without a TTY, Docker multiplexes stdout/stderr into a single stream
+	// framed with an 8-byte header per chunk; stdcopy splits it back out.
+	// With a TTY, the stream is already a single raw stream.
+	if containerInfo.Config.Tty {
+		streamLogLines(logs, "stdout", serviceName, containerInfo.Config.Labels, config, sink)
+		return
+	}
+
+	stdoutReader, stdoutWriter := io.Pipe()
+	stderrReader, stderrWriter := io.Pipe()
+
+	go func() {
+		_, err := stdcopy.StdCopy(stdoutWriter, stderrWriter, logs)
+		stdoutWriter.CloseWithError(err)
+		stderrWriter.CloseWithError(err)
+	}()
+
+	var streamWg sync.WaitGroup
+	streamWg.Add(2)
+	go func() {
+		defer streamWg.Done()
+		streamLogLines(stdoutReader, "stdout", serviceName, containerInfo.Config.Labels, config, sink)
+	}()
+	go func() {
+		defer streamWg.Done()
+		streamLogLines(stderrReader, "stderr", serviceName, containerInfo.Config.Labels, config, sink)
+	}()
+	streamWg.Wait()
+}
+
+// streamLogLines scans a single demultiplexed stream (stdout or stderr),
+// running the sanitize/filter/format pipeline on each line and tagging the
+// output with its originating stream. When sink is non-nil, every line that
+// passes the filter is also written to it in plain text form, independent of
+// the --output mode used for stdout.
+func streamLogLines(r io.Reader, streamName, serviceName string, labels map[string]string, config *streamConfig, sink io.Writer) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		rawLine := scanner.Text()
+		if rawLine == "" {
+			continue
+		}
+
+		timestamp, logLine := splitDockerTimestamp(rawLine)
+
+		// Sanitize the log line
+		logLine = sanitizeLogLine(logLine)
+		if logLine == "" {
+			continue
+		}
+
+		// If the container emits structured JSON itself, prefer its own
+		// level/message over our substring heuristics. A line can carry a
+		// message without a level (or vice versa), so only skip the
+		// heuristic when a level field was actually present.
+		message := logLine
+		level, parsedMsg, hasLevel, hasMessage := format.ParseContainerJSON(logLine)
+		if hasMessage {
+			message = parsedMsg
+		}
+		if !hasLevel {
+			level = detectLevel(strings.ToLower(logLine))
+		}
+
+		if !shouldLogMessage(level, strings.ToLower(logLine), &config.logOptions) {
+			continue
+		}
+
+		hostPrefix := ""
+		if config.hostTag != "" {
+			hostPrefix = fmt.Sprintf("[%s] ", config.hostTag)
+		}
+
+		if sink != nil {
+			fmt.Fprintln(sink, format.TextFormatter{}.Format(format.Entry{
+				Timestamp: timestamp,
+				Container: serviceName,
+				Service:   serviceName,
+				Stream:    streamName,
+				Level:     level,
+				Message:   message,
+				Labels:    labels,
+				Raw:       rawLine,
+			}))
+		}
+
+		if config.outputFormat != "" && config.outputFormat != "text" {
+			// Host is carried as a structured field rather than a raw text
+			// prefix, so multi-host fan-out doesn't corrupt JSON/logfmt
+			// output for pipelines like jq, Loki, or Vector.
+			fmt.Println(config.formatter.Format(format.Entry{
+				Timestamp: timestamp,
+				Host:      config.hostTag,
+				Container: serviceName,
+				Service:   serviceName,
+				Stream:    streamName,
+				Level:     level,
+				Message:   message,
+				Labels:    labels,
+				Raw:       rawLine,
+			}))
+			continue
+		}
+
+		// Determine log style based on level, defaulting stderr lines to
+		// warning color when no explicit level is detected
+		var logColor *color.Color
+		switch {
+		case level == "error":
+			logColor = errorColor
+		case level == "warn" || level == "warning":
+			logColor = warningColor
+		case level == "" && streamName == "stderr":
+			logColor = warningColor
+		default:
+			logColor = normalColor
+		}
+
+		// Print log with timestamp, service name, and stream tag
+		logColor.Printf("%s[%s] [%s] [%s] %s\n", hostPrefix, timestamp.UTC().Format("2006-01-02 15:04:05"), serviceName, streamName, message)
+	}
+
+	if err := scanner.Err(); err != nil && err != io.ErrClosedPipe {
+		fmt.Printf("Error reading %s logs for %s: %v\n", streamName, serviceName, err)
+	}
+}
+
+// splitDockerTimestamp splits a log line prefixed with the RFC3339Nano
+// timestamp Docker adds when ContainerLogsOptions.Timestamps is true,
+// falling back to the current time if the line has no parseable prefix.
+func splitDockerTimestamp(line string) (time.Time, string) {
+	parts := strings.SplitN(line, " ", 2)
+	if len(parts) == 2 {
+		if ts, err := time.Parse(time.RFC3339Nano, parts[0]); err == nil {
+			return ts, parts[1]
+		}
+	}
+	return time.Now(), line
+}
+
+// resolveTimestamp turns a --since/--until value into the Unix timestamp
+// string the Docker API expects. raw may be an RFC3339 timestamp or a
+// relative duration (e.g. "15m", "2h") measured back from now; an empty
+// string is passed through unchanged.
+func resolveTimestamp(raw string) (string, error) {
+	if raw == "" {
+		return "", nil
+	}
+
+	if d, err := time.ParseDuration(raw); err == nil {
+		return fmt.Sprintf("%d", time.Now().Add(-d).Unix()), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return "", fmt.Errorf("expected a relative duration (e.g. 15m, 2h) or an RFC3339 timestamp, got %q", raw)
+	}
+	return fmt.Sprintf("%d", t.Unix()), nil
+}
+
+// detectLevel classifies a line using the substring heuristics below, used
+// as a fallback when the container doesn't emit structured JSON.
+func detectLevel(logLine string) string {
+	switch {
+	case isErrorMessage(logLine):
+		return "error"
+	case isWarningMessage(logLine):
+		return "warn"
+	case isInfoMessage(logLine):
+		return "info"
+	case isDebugMessage(logLine):
+		return "debug"
+	default:
+		return ""
+	}
+}
+
+func shouldLogMessage(level, logLine string, opts *logOptions) bool {
+	if opts.showAll {
+		return true
+	}
+
+	// Check custom keywords first
+	if opts.customWords != "" {
+		customKeywords := strings.Split(opts.customWords, ",")
+		for _, keyword := range customKeywords {
+			if strings.Contains(logLine, strings.TrimSpace(strings.ToLower(keyword))) {
+				return true
+			}
+		}
+	}
+
+	// Check log levels
+	switch level {
+	case "error":
+		return opts.showErrors
+	case "warn", "warning":
+		return opts.showWarns
+	case "info":
+		return opts.showInfo
+	case "debug":
+		return opts.showDebug
+	}
+
+	return false
+}
+
+func isErrorMessage(logLine string) bool {
+	// Check if "Errors: []" or "error: null" appears, indicating no actual errors
+	if strings.Contains(logLine, "errors: []") ||
+		strings.Contains(logLine, "errors:[]") ||
+		strings.Contains(logLine, "error: null") {
+		return false
+	}
+
+	errorPatterns := []struct {
+		keyword string
+		context string
+	}{
+		{"error", ""},
+		{"exception", ""},
+		{"failed", "failure"},
+		{"panic", ""},
+		{"fatal", ""},
+		{"critical", ""},
+	}
+
+	for _, pattern := range errorPatterns {
+		if pattern.context == "" {
+			// Simple keyword match
+			if strings.Contains(logLine, pattern.keyword) {
+				// Make sure it's not part of a "no error" or "error: null" message
+				if !strings.Contains(logLine, "no "+pattern.keyword) &&
+					!strings.Contains(logLine, pattern.keyword+": null") {
+					return true
+				}
+			}
+		} else {
+			// Contextual match
+			if strings.Contains(logLine, pattern.keyword) &&
+				strings.Contains(logLine, pattern.context) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isWarningMessage(logLine string) bool {
+	// Skip if it's a status change or success message
+	if strings.Contains(logLine, "status from") ||
+		strings.Contains(logLine, "changed status") ||
+		strings.Contains(logLine, "success") {
+		return false
+	}
+
+	warningKeywords := []string{
+		"warn",
+		"warning",
+		"deprecated",
+		"timeout",
+		"unavailable",
+	}
+
+	// Only check "retry" if it's accompanied by an error context
+	if strings.Contains(logLine, "retry") &&
+		(strings.Contains(logLine, "failed") ||
+			strings.Contains(logLine, "error") ||
+			strings.Contains(logLine, "timeout")) {
+		return true
+	}
+
+	for _, keyword := range warningKeywords {
+		if strings.Contains(logLine, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+func isInfoMessage(logLine string) bool {
+	infoKeywords := []string{
+		"info",
+		"information",
+		"notice",
+		"success",
+	}
+	for _, keyword := range infoKeywords {
+		if strings.Contains(logLine, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+func isDebugMessage(logLine string) bool {
+	debugKeywords := []string{
+		"debug",
+		"trace",
+		"verbose",
+	}
+	for _, keyword := range debugKeywords {
+		if strings.Contains(logLine, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesServiceName reports whether containerName matches one of the
+// --service partial-match filters, or true if none were given.
+func matchesServiceName(containerName string, serviceFilters []string) bool {
+	if len(serviceFilters) == 0 {
+		return true
+	}
+
+	containerNameLower := strings.ToLower(containerName)
+	for _, filter := range serviceFilters {
+		if strings.Contains(containerNameLower, strings.ToLower(filter)) {
+			return true
+		}
+	}
+	return false
+}
+
+func sanitizeLogLine(logLine string) string {
+	// Remove common control characters and invalid UTF-8 sequences
+	sanitized := strings.Map(func(r rune) rune {
+		if r < 32 && r != '\t' && r != '\n' && r != '\r' {
+			return -1
+		}
+		return r
+	}, logLine)
+
+	// Handle JSON-like structures
+	sanitized = strings.ReplaceAll(sanitized, "\u0000", "")
+
+	// Remove ANSI escape sequences
+	ansiRegex := regexp.MustCompile(`\x1b\[[0-9;]*[a-zA-Z]`)
+	sanitized = ansiRegex.ReplaceAllString(sanitized, "")
+
+	return strings.TrimSpace(sanitized)
+}
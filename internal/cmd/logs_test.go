@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestResolveTimestampEmpty(t *testing.T) {
+	got, err := resolveTimestamp("")
+	if err != nil {
+		t.Fatalf("resolveTimestamp(\"\") error = %v", err)
+	}
+	if got != "" {
+		t.Errorf("resolveTimestamp(\"\") = %q, want empty string", got)
+	}
+}
+
+func TestResolveTimestampRelativeDuration(t *testing.T) {
+	before := time.Now().Add(-15 * time.Minute).Unix()
+	got, err := resolveTimestamp("15m")
+	if err != nil {
+		t.Fatalf("resolveTimestamp(\"15m\") error = %v", err)
+	}
+	after := time.Now().Add(-15 * time.Minute).Unix()
+
+	ts, err := strconv.ParseInt(got, 10, 64)
+	if err != nil {
+		t.Fatalf("resolveTimestamp(\"15m\") = %q, not a Unix timestamp: %v", got, err)
+	}
+	if ts < before || ts > after {
+		t.Errorf("resolveTimestamp(\"15m\") = %d, want between %d and %d", ts, before, after)
+	}
+}
+
+func TestResolveTimestampRFC3339(t *testing.T) {
+	got, err := resolveTimestamp("2026-01-02T03:04:05Z")
+	if err != nil {
+		t.Fatalf("resolveTimestamp(RFC3339) error = %v", err)
+	}
+	want := strconv.FormatInt(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC).Unix(), 10)
+	if got != want {
+		t.Errorf("resolveTimestamp(RFC3339) = %q, want %q", got, want)
+	}
+}
+
+func TestResolveTimestampInvalid(t *testing.T) {
+	if _, err := resolveTimestamp("not-a-time"); err == nil {
+		t.Error("resolveTimestamp(\"not-a-time\") error = nil, want error")
+	}
+}
+
+func TestShortHostTag(t *testing.T) {
+	tests := []struct {
+		host string
+		want string
+	}{
+		{host: "", want: "local"},
+		{host: "tcp://prod-1.example.com:2376", want: "prod-1"},
+		{host: "unix:///var/run/docker.sock", want: "/var/run/docker"},
+		{host: "ssh://build-box", want: "build-box"},
+	}
+
+	for _, tt := range tests {
+		if got := shortHostTag(tt.host); got != tt.want {
+			t.Errorf("shortHostTag(%q) = %q, want %q", tt.host, got, tt.want)
+		}
+	}
+}
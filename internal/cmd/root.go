@@ -0,0 +1,109 @@
+// Package cmd builds the docker-logger Cobra command tree: a root command
+// carrying the persistent --host/TLS/--output flags shared by every
+// subcommand, and the logs/events/inspect/version subcommands themselves.
+package cmd
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jhkimqd/docker-logger/internal/docker"
+)
+
+// rootOptions holds the flags persistent across every subcommand: which
+// daemon(s) to talk to, how to authenticate, and how to render output.
+type rootOptions struct {
+	hosts        []string
+	tls          bool
+	tlsVerify    bool
+	tlsCACert    string
+	tlsCert      string
+	tlsKey       string
+	outputFormat string
+}
+
+// NewRootCommand builds the docker-logger command tree.
+func NewRootCommand() *cobra.Command {
+	opts := &rootOptions{}
+
+	root := &cobra.Command{
+		Use:   "docker-logger",
+		Short: "Tail, filter, and ship logs for containers on a Docker network",
+		Long: `docker-logger tails container logs for every container attached to a Docker
+network, watching Docker events to pick up containers that join later and
+tear down streams for containers that leave or die, with optional
+label/Compose filtering, structured output, and multi-host fan-out.`,
+		SilenceUsage:  true,
+		SilenceErrors: false,
+	}
+	root.CompletionOptions.DisableDefaultCmd = true
+
+	persistent := root.PersistentFlags()
+	persistent.StringArrayVar(&opts.hosts, "host", nil, "Docker daemon socket to connect to (repeatable to fan out across multiple engines)")
+	persistent.BoolVar(&opts.tls, "tls", false, "Use TLS when connecting to the Docker daemon")
+	persistent.BoolVar(&opts.tlsVerify, "tlsverify", false, "Use TLS and verify the remote daemon's certificate")
+	persistent.StringVar(&opts.tlsCACert, "tlscacert", "", "Path to the TLS CA certificate")
+	persistent.StringVar(&opts.tlsCert, "tlscert", "", "Path to the TLS client certificate")
+	persistent.StringVar(&opts.tlsKey, "tlskey", "", "Path to the TLS client key")
+	persistent.StringVar(&opts.outputFormat, "output", "text", "Output format: text, json, or logfmt")
+
+	root.AddCommand(newLogsCommand(opts))
+	root.AddCommand(newEventsCommand(opts))
+	root.AddCommand(newInspectCommand(opts))
+	root.AddCommand(newVersionCommand())
+
+	return root
+}
+
+// clientOptionsList resolves rootOptions plus the DOCKER_HOST/DOCKER_CERT_PATH/
+// DOCKER_TLS_VERIFY environment defaults into one docker.ClientOptions per
+// host to fan out across.
+func (o *rootOptions) clientOptionsList() []docker.ClientOptions {
+	envOpts := docker.ClientOptionsFromEnv()
+	base := docker.ClientOptions{
+		TLS:       envOpts.TLS || o.tls || o.tlsVerify,
+		TLSVerify: envOpts.TLSVerify || o.tlsVerify,
+		TLSCACert: firstNonEmpty(o.tlsCACert, envOpts.TLSCACert),
+		TLSCert:   firstNonEmpty(o.tlsCert, envOpts.TLSCert),
+		TLSKey:    firstNonEmpty(o.tlsKey, envOpts.TLSKey),
+	}
+
+	hosts := o.hosts
+	if len(hosts) == 0 {
+		hosts = []string{envOpts.Host}
+	}
+
+	list := make([]docker.ClientOptions, 0, len(hosts))
+	for _, h := range hosts {
+		co := base
+		co.Host = h
+		list = append(list, co)
+	}
+	return list
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// shortHostTag derives a short tag from a --host value for prefixing output
+// in multi-host fan-out, e.g. "tcp://prod-1.example.com:2376" -> "prod-1".
+func shortHostTag(host string) string {
+	if host == "" {
+		return "local"
+	}
+	tag := host
+	if idx := strings.Index(tag, "://"); idx != -1 {
+		tag = tag[idx+3:]
+	}
+	if idx := strings.IndexAny(tag, ":."); idx != -1 {
+		tag = tag[:idx]
+	}
+	return tag
+}
@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// version is overridden at build time via -ldflags "-X ...version=vX.Y.Z".
+var version = "dev"
+
+func newVersionCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "version",
+		Short: "Print the docker-logger version",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fmt.Println("docker-logger", version)
+			return nil
+		},
+	}
+}
@@ -2,19 +2,103 @@ package docker
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
 	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/client"
 )
 
-// CreateClient initializes a new Docker client.
-func CreateClient() (*client.Client, error) {
-	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+// ClientOptions configures how CreateClient connects to a Docker daemon,
+// mirroring the standard docker CLI's --host/--tls* flags.
+type ClientOptions struct {
+	Host      string
+	TLS       bool
+	TLSVerify bool
+	TLSCACert string
+	TLSCert   string
+	TLSKey    string
+}
+
+// ClientOptionsFromEnv seeds ClientOptions from DOCKER_HOST, DOCKER_CERT_PATH,
+// and DOCKER_TLS_VERIFY, the same environment variables the standard docker
+// CLI defaults to.
+func ClientOptionsFromEnv() ClientOptions {
+	opts := ClientOptions{
+		Host:      os.Getenv("DOCKER_HOST"),
+		TLSVerify: os.Getenv("DOCKER_TLS_VERIFY") != "",
+	}
+	if certPath := os.Getenv("DOCKER_CERT_PATH"); certPath != "" {
+		opts.TLS = true
+		opts.TLSCACert = filepath.Join(certPath, "ca.pem")
+		opts.TLSCert = filepath.Join(certPath, "cert.pem")
+		opts.TLSKey = filepath.Join(certPath, "key.pem")
+	}
+	return opts
+}
+
+// CreateClient initializes a new Docker client for the given host and TLS
+// options, falling back to the standard client.FromEnv defaults for
+// anything left unset.
+func CreateClient(opts ClientOptions) (*client.Client, error) {
+	clientOpts := []client.Opt{client.FromEnv, client.WithAPIVersionNegotiation()}
+
+	if opts.Host != "" {
+		clientOpts = append(clientOpts, client.WithHost(opts.Host))
+	}
+
+	if opts.TLS || opts.TLSVerify {
+		tlsConfig, err := buildTLSConfig(opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build TLS config: %w", err)
+		}
+		clientOpts = append(clientOpts, client.WithHTTPClient(&http.Client{
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		}))
+	}
+
+	cli, err := client.NewClientWithOpts(clientOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return cli, nil
 }
 
+// buildTLSConfig assembles a tls.Config from the client certificate/key and
+// CA certificate paths in opts, skipping server certificate verification
+// unless TLSVerify is set (matching docker's --tls vs --tlsverify distinction).
+func buildTLSConfig(opts ClientOptions) (*tls.Config, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: !opts.TLSVerify}
+
+	if opts.TLSCert != "" && opts.TLSKey != "" {
+		cert, err := tls.LoadX509KeyPair(opts.TLSCert, opts.TLSKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if opts.TLSCACert != "" {
+		caCert, err := os.ReadFile(opts.TLSCACert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+		}
+		caPool := x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("failed to parse CA certificate %s", opts.TLSCACert)
+		}
+		tlsConfig.RootCAs = caPool
+	}
+
+	return tlsConfig, nil
+}
+
 // InspectNetwork retrieves details about a Docker network.
 func InspectNetwork(ctx context.Context, cli *client.Client, networkName string) (types.NetworkResource, error) {
 	network, err := cli.NetworkInspect(ctx, networkName, types.NetworkInspectOptions{})
@@ -22,4 +106,86 @@ func InspectNetwork(ctx context.Context, cli *client.Client, networkName string)
 		return types.NetworkResource{}, err
 	}
 	return network, nil
-}
\ No newline at end of file
+}
+
+// DiscoverOptions narrows down which containers in a network should be
+// tailed, as an alternative to client-side substring matching on names.
+type DiscoverOptions struct {
+	Labels         []string // "key=value" pairs, repeatable, ANDed together
+	ComposeProject string
+	ComposeService string
+}
+
+// DiscoverContainers resolves the set of containers to tail for a network by
+// pushing label and Compose project/service selection down to the daemon via
+// filters.Args, rather than relying on a client-side substring check on
+// container names. The result is intersected with the containers Docker
+// reports as attached to the network, so a selector can't accidentally pull
+// in a same-labeled container from elsewhere.
+func DiscoverContainers(ctx context.Context, cli *client.Client, networkName string, opts DiscoverOptions) ([]types.Container, error) {
+	network, err := InspectNetwork(ctx, cli, networkName)
+	if err != nil {
+		return nil, err
+	}
+
+	filterArgs := filters.NewArgs(filters.Arg("network", networkName))
+	for _, label := range opts.Labels {
+		filterArgs.Add("label", label)
+	}
+	if opts.ComposeProject != "" {
+		filterArgs.Add("label", "com.docker.compose.project="+opts.ComposeProject)
+	}
+	if opts.ComposeService != "" {
+		filterArgs.Add("label", "com.docker.compose.service="+opts.ComposeService)
+	}
+
+	containers, err := cli.ContainerList(ctx, types.ContainerListOptions{Filters: filterArgs})
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []types.Container
+	for _, c := range containers {
+		if _, inNetwork := network.Containers[c.ID]; inNetwork {
+			matched = append(matched, c)
+		}
+	}
+	return matched, nil
+}
+
+// ContainerDisplayName returns a container's primary name with its leading
+// slash trimmed, falling back to its ID if Docker reported no name.
+func ContainerDisplayName(c types.Container) string {
+	if len(c.Names) > 0 {
+		return strings.TrimPrefix(c.Names[0], "/")
+	}
+	return c.ID
+}
+
+// MatchesDiscoverOptions reports whether a container's labels satisfy opts,
+// the same label/Compose project/service selection DiscoverContainers pushes
+// down to the daemon. Callers that learn about a container outside of
+// DiscoverContainers (e.g. a "container joined the network" event) use this
+// to apply the identical selection client-side.
+func MatchesDiscoverOptions(labels map[string]string, opts DiscoverOptions) bool {
+	for _, label := range opts.Labels {
+		key, value, ok := strings.Cut(label, "=")
+		if !ok {
+			// A bare "key" selects containers that carry the label at all.
+			if _, has := labels[key]; !has {
+				return false
+			}
+			continue
+		}
+		if labels[key] != value {
+			return false
+		}
+	}
+	if opts.ComposeProject != "" && labels["com.docker.compose.project"] != opts.ComposeProject {
+		return false
+	}
+	if opts.ComposeService != "" && labels["com.docker.compose.service"] != opts.ComposeService {
+		return false
+	}
+	return true
+}
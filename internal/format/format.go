@@ -0,0 +1,129 @@
+// Package format renders log entries for the different --output modes
+// (text, json, logfmt), so the streaming pipeline doesn't need to know how
+// a line ends up on the wire.
+package format
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Entry is one log line, already demultiplexed and timestamped, ready to be
+// rendered by a Formatter.
+type Entry struct {
+	Timestamp time.Time
+	Host      string // non-empty only when fanning out across multiple --host values
+	Container string
+	Service   string
+	Stream    string
+	Level     string
+	Message   string
+	Labels    map[string]string
+	Raw       string
+}
+
+// Formatter renders an Entry as a single line of output.
+type Formatter interface {
+	Format(e Entry) string
+}
+
+// New returns the Formatter for the given --output mode. An empty mode is
+// treated as "text".
+func New(mode string) (Formatter, error) {
+	switch mode {
+	case "", "text":
+		return TextFormatter{}, nil
+	case "json":
+		return JSONFormatter{}, nil
+	case "logfmt":
+		return LogfmtFormatter{}, nil
+	default:
+		return nil, fmt.Errorf("unknown output format %q (want text, json, or logfmt)", mode)
+	}
+}
+
+// TextFormatter renders a plain "[ts] [service] [stream] msg" line, the same
+// shape the tool has always printed to the terminal.
+type TextFormatter struct{}
+
+func (TextFormatter) Format(e Entry) string {
+	hostPrefix := ""
+	if e.Host != "" {
+		hostPrefix = fmt.Sprintf("[%s] ", e.Host)
+	}
+	return fmt.Sprintf("%s[%s] [%s] [%s] %s", hostPrefix, e.Timestamp.UTC().Format("2006-01-02 15:04:05"), e.Service, e.Stream, e.Message)
+}
+
+// JSONFormatter renders one JSON object per line, pipe-friendly for jq,
+// Loki, or Vector.
+type JSONFormatter struct{}
+
+type jsonLine struct {
+	Timestamp string            `json:"ts"`
+	Host      string            `json:"host,omitempty"`
+	Container string            `json:"container"`
+	Service   string            `json:"service"`
+	Stream    string            `json:"stream"`
+	Level     string            `json:"level"`
+	Message   string            `json:"msg"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Raw       string            `json:"raw"`
+}
+
+func (JSONFormatter) Format(e Entry) string {
+	line := jsonLine{
+		Timestamp: e.Timestamp.UTC().Format(time.RFC3339Nano),
+		Host:      e.Host,
+		Container: e.Container,
+		Service:   e.Service,
+		Stream:    e.Stream,
+		Level:     e.Level,
+		Message:   e.Message,
+		Labels:    e.Labels,
+		Raw:       e.Raw,
+	}
+	b, err := json.Marshal(line)
+	if err != nil {
+		return e.Raw
+	}
+	return string(b)
+}
+
+// LogfmtFormatter renders key=value pairs, one per field.
+type LogfmtFormatter struct{}
+
+func (LogfmtFormatter) Format(e Entry) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ts=%s", e.Timestamp.UTC().Format(time.RFC3339Nano))
+	if e.Host != "" {
+		fmt.Fprintf(&b, " host=%s", logfmtValue(e.Host))
+	}
+	fmt.Fprintf(&b, " container=%s service=%s stream=%s level=%s msg=%s",
+		logfmtValue(e.Container),
+		logfmtValue(e.Service),
+		e.Stream,
+		e.Level,
+		logfmtValue(e.Message),
+	)
+
+	keys := make([]string, 0, len(e.Labels))
+	for k := range e.Labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(&b, " %s=%s", k, logfmtValue(e.Labels[k]))
+	}
+
+	return b.String()
+}
+
+func logfmtValue(v string) string {
+	if strings.ContainsAny(v, " \"=") {
+		return fmt.Sprintf("%q", v)
+	}
+	return v
+}
@@ -0,0 +1,123 @@
+package format
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func testEntry() Entry {
+	return Entry{
+		Timestamp: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Container: "web-1",
+		Service:   "web",
+		Stream:    "stdout",
+		Level:     "info",
+		Message:   "hello world",
+		Labels:    map[string]string{"com.docker.compose.project": "demo"},
+		Raw:       `{"level":"info","msg":"hello world"}`,
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		mode    string
+		want    Formatter
+		wantErr bool
+	}{
+		{mode: "", want: TextFormatter{}},
+		{mode: "text", want: TextFormatter{}},
+		{mode: "json", want: JSONFormatter{}},
+		{mode: "logfmt", want: LogfmtFormatter{}},
+		{mode: "xml", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := New(tt.mode)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("New(%q) error = nil, want error", tt.mode)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("New(%q) error = %v", tt.mode, err)
+		}
+		if got != tt.want {
+			t.Errorf("New(%q) = %#v, want %#v", tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestTextFormatterFormat(t *testing.T) {
+	got := TextFormatter{}.Format(testEntry())
+	want := "[2026-01-02 03:04:05] [web] [stdout] hello world"
+	if got != want {
+		t.Errorf("TextFormatter.Format() = %q, want %q", got, want)
+	}
+}
+
+func TestJSONFormatterFormat(t *testing.T) {
+	got := JSONFormatter{}.Format(testEntry())
+	for _, want := range []string{`"service":"web"`, `"stream":"stdout"`, `"level":"info"`, `"msg":"hello world"`, `"com.docker.compose.project":"demo"`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("JSONFormatter.Format() = %s, want it to contain %s", got, want)
+		}
+	}
+	if strings.Contains(got, `"host"`) {
+		t.Errorf("JSONFormatter.Format() = %s, want no host field when Host is empty", got)
+	}
+}
+
+func TestLogfmtFormatterFormat(t *testing.T) {
+	got := LogfmtFormatter{}.Format(testEntry())
+	for _, want := range []string{"service=web", "stream=stdout", "level=info", `msg="hello world"`, "com.docker.compose.project=demo"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("LogfmtFormatter.Format() = %s, want it to contain %s", got, want)
+		}
+	}
+	if strings.Contains(got, "host=") {
+		t.Errorf("LogfmtFormatter.Format() = %s, want no host field when Host is empty", got)
+	}
+}
+
+func TestFormattersRenderHostAsStructuredField(t *testing.T) {
+	entry := testEntry()
+	entry.Host = "prod-1"
+
+	textGot := TextFormatter{}.Format(entry)
+	if textGot != "[prod-1] [2026-01-02 03:04:05] [web] [stdout] hello world" {
+		t.Errorf("TextFormatter.Format() = %q, want host prefix", textGot)
+	}
+
+	// A multi-host Entry must still start as a JSON object and carry the
+	// host in a dedicated field, not concatenated raw text in front of the
+	// object -- that's the bug this guards against.
+	jsonGot := JSONFormatter{}.Format(entry)
+	if !strings.HasPrefix(jsonGot, "{") || !strings.Contains(jsonGot, `"host":"prod-1"`) {
+		t.Errorf("JSONFormatter.Format() = %s, want a single JSON object with a host field", jsonGot)
+	}
+
+	logfmtGot := LogfmtFormatter{}.Format(entry)
+	if !strings.Contains(logfmtGot, "host=prod-1") {
+		t.Errorf("LogfmtFormatter.Format() = %s, want a host=prod-1 field", logfmtGot)
+	}
+}
+
+func TestLogfmtValueQuoting(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"plain", "plain"},
+		{"has space", `"has space"`},
+		{`has"quote`, `"has\"quote"`},
+		{"has=equals", `"has=equals"`},
+	}
+
+	for _, tt := range tests {
+		if got := logfmtValue(tt.in); got != tt.want {
+			t.Errorf("logfmtValue(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package format
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// levelFields and messageFields are the keys recognized across the common
+// Go/Node structured-logging conventions (zap, logrus, bunyan).
+var (
+	levelFields   = []string{"level", "severity", "lvl"}
+	messageFields = []string{"msg", "message", "error"}
+)
+
+// ParseContainerJSON attempts to parse a container's own log line as a JSON
+// object and pull a level and message out of it using common structured-log
+// field names, promoting a nested message/error field to the top level.
+// hasLevel and hasMessage report which of the two were actually found, since
+// a line can carry one without the other (e.g. a bare {"msg": "..."} with no
+// level field) - callers should only skip their own level-detection
+// heuristic when hasLevel is true, not merely because hasMessage is true.
+// All four results are zero values if the line isn't a JSON object at all.
+func ParseContainerJSON(raw string) (level, message string, hasLevel, hasMessage bool) {
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(raw), &fields); err != nil {
+		return "", "", false, false
+	}
+
+	for _, key := range levelFields {
+		v, exists := fields[key]
+		if !exists {
+			continue
+		}
+		if s, isStr := v.(string); isStr {
+			level = strings.ToLower(s)
+			hasLevel = true
+			break
+		}
+	}
+
+	for _, key := range messageFields {
+		v, exists := fields[key]
+		if !exists {
+			continue
+		}
+		switch m := v.(type) {
+		case string:
+			message = m
+		case map[string]interface{}:
+			if b, err := json.Marshal(m); err == nil {
+				message = string(b)
+			}
+		}
+		if message != "" {
+			hasMessage = true
+			break
+		}
+	}
+
+	return level, message, hasLevel, hasMessage
+}
@@ -0,0 +1,81 @@
+package format
+
+import "testing"
+
+func TestParseContainerJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		raw         string
+		wantLevel   string
+		wantMessage string
+		wantHasLvl  bool
+		wantHasMsg  bool
+	}{
+		{
+			name:        "level and message present",
+			raw:         `{"level":"ERROR","msg":"connection refused"}`,
+			wantLevel:   "error",
+			wantMessage: "connection refused",
+			wantHasLvl:  true,
+			wantHasMsg:  true,
+		},
+		{
+			name:        "severity and message field names",
+			raw:         `{"severity":"warn","message":"disk almost full"}`,
+			wantLevel:   "warn",
+			wantMessage: "disk almost full",
+			wantHasLvl:  true,
+			wantHasMsg:  true,
+		},
+		{
+			name:       "message without a level field",
+			raw:        `{"msg":"fatal error: db connection refused"}`,
+			wantHasLvl: false,
+			wantHasMsg: true,
+		},
+		{
+			name:       "level without a message field",
+			raw:        `{"lvl":"info"}`,
+			wantLevel:  "info",
+			wantHasLvl: true,
+			wantHasMsg: false,
+		},
+		{
+			name:       "nested error object is promoted to a JSON string message",
+			raw:        `{"level":"error","error":{"code":500,"detail":"boom"}}`,
+			wantLevel:  "error",
+			wantHasLvl: true,
+			wantHasMsg: true,
+		},
+		{
+			name:       "not JSON at all",
+			raw:        "plain text log line",
+			wantHasLvl: false,
+			wantHasMsg: false,
+		},
+		{
+			name:       "JSON object with no recognized fields",
+			raw:        `{"foo":"bar"}`,
+			wantHasLvl: false,
+			wantHasMsg: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			level, message, hasLevel, hasMessage := ParseContainerJSON(tt.raw)
+			if hasLevel != tt.wantHasLvl {
+				t.Errorf("hasLevel = %v, want %v", hasLevel, tt.wantHasLvl)
+			}
+			if hasMessage != tt.wantHasMsg {
+				t.Errorf("hasMessage = %v, want %v", hasMessage, tt.wantHasMsg)
+			}
+			if tt.wantHasLvl && level != tt.wantLevel {
+				t.Errorf("level = %q, want %q", level, tt.wantLevel)
+			}
+			if tt.wantHasMsg && tt.wantMessage != "" && message != tt.wantMessage {
+				t.Errorf("message = %q, want %q", message, tt.wantMessage)
+			}
+		})
+	}
+}